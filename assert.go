@@ -6,7 +6,6 @@ package assert // import "fortio.org/assert"
 import (
 	"fmt"
 	"reflect"
-	"regexp"
 	"runtime"
 	"strings"
 	"testing"
@@ -72,9 +71,15 @@ func False(t *testing.T, b bool, msg ...string) {
 	}
 }
 
-// Contains checks that needle is in haystack.
-func Contains(t *testing.T, haystack, needle string, msg ...string) {
-	if !strings.Contains(haystack, needle) {
+// Contains checks that needle is in haystack: a substring of a string, an
+// element of a slice/array, or a key of a map. See collections.go.
+func Contains(t *testing.T, haystack, needle interface{}, msg ...string) {
+	ok, found := ContainsElement(haystack, needle)
+	if !ok {
+		Errorf(t, "%v (%T) can't be checked for containment: %v", haystack, haystack, msg)
+		return
+	}
+	if !found {
 		Errorf(t, "%v doesn't contain %v: %v", haystack, needle, msg)
 	}
 }
@@ -108,70 +113,3 @@ func Assert(t *testing.T, cond bool, msg interface{}) {
 		t.Fail()
 	}
 }
-
-type hasT interface {
-	T() *testing.T
-	SetT(*testing.T)
-}
-
-// TestSuite to be used as base struct for test suites.
-// replaces https://pkg.go.dev/github.com/stretchr/testify@v1.8.0/suite
-type TestSuite struct {
-	t *testing.T
-}
-
-// T returns the current testing.T.
-func (s *TestSuite) T() *testing.T {
-	return s.t
-}
-
-// SetT sets the testing.T in the suite object.
-func (s *TestSuite) SetT(t *testing.T) {
-	s.t = t
-}
-
-type hasSetupTest interface {
-	SetupTest()
-}
-type hasTearDown interface {
-	TearDownTest()
-}
-
-// Run runs the test suite with SetupTest first and TearDownTest after.
-// replaces https://pkg.go.dev/github.com/stretchr/testify/suite#Run
-func Run(t *testing.T, suite hasT) {
-	suite.SetT(t)
-	tests := []testing.InternalTest{}
-	methodFinder := reflect.TypeOf(suite)
-	var setup hasSetupTest
-	if s, ok := suite.(hasSetupTest); ok {
-		setup = s
-	}
-	var tearDown hasTearDown
-	if td, ok := suite.(hasTearDown); ok {
-		tearDown = td
-	}
-	for i := 0; i < methodFinder.NumMethod(); i++ {
-		method := methodFinder.Method(i)
-		//nolint:staticcheck // consider fixing later for perf but this is just to run a few tests.
-		if ok, _ := regexp.MatchString("^Test", method.Name); !ok {
-			continue
-		}
-		test := testing.InternalTest{
-			Name: method.Name,
-			F: func(t *testing.T) {
-				method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
-			},
-		}
-		tests = append(tests, test)
-	}
-	for _, test := range tests {
-		if setup != nil {
-			setup.SetupTest()
-		}
-		t.Run(test.Name, test.F)
-		if tearDown != nil {
-			tearDown.TearDownTest()
-		}
-	}
-}
@@ -0,0 +1,191 @@
+// Copyright 2022 Fortio Authors
+
+package assert
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ContainsElement, GetLen, IsEmpty, DiffElements and SequenceValue below are
+// exported beyond what this file's own assertions need so the require
+// subpackage can delegate to the same logic instead of duplicating it.
+
+// ContainsElement reports whether haystack is a kind that can be searched
+// (ok) and, if so, whether needle was found in it (found). Strings are
+// searched as substrings, slices/arrays by equal element and maps by equal
+// key.
+func ContainsElement(haystack, needle interface{}) (ok, found bool) {
+	if hs, isStr := haystack.(string); isStr {
+		ns, isStr := needle.(string)
+		if !isStr {
+			return false, false
+		}
+		return true, strings.Contains(hs, ns)
+	}
+	hValue := reflect.ValueOf(haystack)
+	switch hValue.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < hValue.Len(); i++ {
+			if ObjectsAreEqualValues(hValue.Index(i).Interface(), needle) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Map:
+		for _, k := range hValue.MapKeys() {
+			if ObjectsAreEqualValues(k.Interface(), needle) {
+				return true, true
+			}
+		}
+		return true, false
+	default:
+		return false, false
+	}
+}
+
+// GetLen returns the length of obj and whether obj is a kind that has one.
+func GetLen(obj interface{}) (int, bool) {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+		return v.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// Len checks that obj (a slice, array, map, string or channel) has length n.
+func Len(t *testing.T, obj interface{}, n int, msg ...string) {
+	l, ok := GetLen(obj)
+	if !ok {
+		Errorf(t, "%v (%T) doesn't have a length: %v", obj, obj, msg)
+		return
+	}
+	if l != n {
+		Errorf(t, "%v has length %d, not %d: %v", obj, l, n, msg)
+	}
+}
+
+// IsEmpty reports whether obj is nil, the zero value for its type, or has
+// length 0 (for slices, arrays, maps, strings and channels). A non-nil
+// pointer is empty when the value it points to is empty.
+func IsEmpty(obj interface{}) bool {
+	if obj == nil {
+		return true
+	}
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.String, reflect.Chan:
+		return v.Len() == 0
+	case reflect.Ptr:
+		if v.IsNil() {
+			return true
+		}
+		return IsEmpty(v.Elem().Interface())
+	default:
+		return ObjectsAreEqualValues(obj, reflect.Zero(v.Type()).Interface())
+	}
+}
+
+// Empty checks that obj is empty as defined by IsEmpty.
+func Empty(t *testing.T, obj interface{}, msg ...string) {
+	if !IsEmpty(obj) {
+		Errorf(t, "%v unexpectedly not empty: %v", obj, msg)
+	}
+}
+
+// NotEmpty checks that obj is not empty as defined by IsEmpty.
+func NotEmpty(t *testing.T, obj interface{}, msg ...string) {
+	if IsEmpty(obj) {
+		Errorf(t, "unexpectedly empty: %v", msg)
+	}
+}
+
+// DiffElements returns the elements of aValue that can't be matched
+// one-for-one against an unused element of bValue.
+func DiffElements(aValue, bValue reflect.Value) []interface{} {
+	used := make([]bool, bValue.Len())
+	var extra []interface{}
+	for i := 0; i < aValue.Len(); i++ {
+		aElem := aValue.Index(i).Interface()
+		found := false
+		for j := 0; j < bValue.Len(); j++ {
+			if used[j] {
+				continue
+			}
+			if ObjectsAreEqualValues(aElem, bValue.Index(j).Interface()) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			extra = append(extra, aElem)
+		}
+	}
+	return extra
+}
+
+// SequenceValue returns the reflect.Value of obj and true if obj is a slice
+// or array, or the zero Value and false otherwise.
+func SequenceValue(obj interface{}) (reflect.Value, bool) {
+	v := reflect.ValueOf(obj)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return v, true
+	default:
+		return reflect.Value{}, false
+	}
+}
+
+// ElementsMatch checks that listA and listB (slices or arrays) contain the
+// same elements, irrespective of order: a multiset/counter comparison, not
+// a set one, so duplicates must match in count too.
+func ElementsMatch(t *testing.T, listA, listB interface{}, msg ...string) {
+	if IsEmpty(listA) && IsEmpty(listB) {
+		return
+	}
+	aValue, aOK := SequenceValue(listA)
+	bValue, bOK := SequenceValue(listB)
+	if !aOK || !bOK {
+		Errorf(t, "%v (%T) and %v (%T) must both be a slice or array: %v", listA, listA, listB, listB, msg)
+		return
+	}
+	if aValue.Len() != bValue.Len() {
+		Errorf(t, "%v and %v don't have the same length: %v", listA, listB, msg)
+		return
+	}
+	if extra := DiffElements(aValue, bValue); len(extra) > 0 {
+		Errorf(t, "%v and %v don't contain the same elements, unmatched: %v: %v", listA, listB, extra, msg)
+	}
+}
+
+// Subset checks that every element of sub (a slice or array) is present in
+// super.
+func Subset(t *testing.T, super, sub interface{}, msg ...string) {
+	if IsEmpty(sub) {
+		return
+	}
+	superValue, superOK := SequenceValue(super)
+	subValue, subOK := SequenceValue(sub)
+	if !superOK || !subOK {
+		Errorf(t, "%v (%T) and %v (%T) must both be a slice or array: %v", super, super, sub, sub, msg)
+		return
+	}
+	for i := 0; i < subValue.Len(); i++ {
+		elem := subValue.Index(i).Interface()
+		found := false
+		for j := 0; j < superValue.Len(); j++ {
+			if ObjectsAreEqualValues(elem, superValue.Index(j).Interface()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			Errorf(t, "%v is not a subset of %v, missing %v: %v", sub, super, elem, msg)
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 Fortio Authors
+
+package assert_test
+
+import (
+	"testing"
+
+	"fortio.org/assert"
+)
+
+func TestContainsStringSliceMap(t *testing.T) {
+	assert.Contains(t, "hello world", "world")
+	assert.Contains(t, []int{1, 2, 3}, 2)
+	assert.Contains(t, map[string]int{"a": 1, "b": 2}, "b")
+}
+
+func TestLenEmptyNotEmpty(t *testing.T) {
+	assert.Len(t, []int{1, 2, 3}, 3)
+	assert.Len(t, "abcd", 4)
+	assert.Empty(t, []int{})
+	assert.Empty(t, "")
+	assert.NotEmpty(t, []int{1})
+}
+
+func TestElementsMatchAndSubset(t *testing.T) {
+	assert.ElementsMatch(t, []int{1, 2, 2, 3}, []int{3, 2, 1, 2})
+	assert.Subset(t, []int{1, 2, 3}, []int{3, 1})
+}
+
+func TestElementsMatchAndSubsetDoNotPanicOnBadKind(t *testing.T) {
+	tt := &testing.T{}
+	assert.ElementsMatch(tt, 5, []int{1})
+	if !tt.Failed() {
+		t.Error("expecting ElementsMatch() on a non-slice to fail, not panic")
+	}
+
+	tt2 := &testing.T{}
+	assert.Subset(tt2, 5, []int{1})
+	if !tt2.Failed() {
+		t.Error("expecting Subset() on a non-slice to fail, not panic")
+	}
+}
+
+func TestSubsetFailure(t *testing.T) {
+	tt := &testing.T{}
+	assert.Subset(tt, []int{1, 2}, []int{3})
+	if !tt.Failed() {
+		t.Error("expecting Subset() missing an element to fail")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Fortio Authors
+
+package assert
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// Compare returns -1 if a<b, 0 if a==b and 1 if a>b, plus whether the
+// comparison was actually possible: ordered is false when a and b are of
+// different kinds or of a kind that isn't ordered (e.g. structs, slices).
+// Mirrors testify's internal compare() dispatch over reflect.Kind.
+func Compare(a, b interface{}) (result int, ordered bool) {
+	aValue := reflect.ValueOf(a)
+	bValue := reflect.ValueOf(b)
+	if aValue.Kind() != bValue.Kind() {
+		return 0, false
+	}
+	switch aValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		a, b := aValue.Int(), bValue.Int()
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		a, b := aValue.Uint(), bValue.Uint()
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.Float32, reflect.Float64:
+		a, b := aValue.Float(), bValue.Float()
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case reflect.String:
+		a, b := aValue.String(), bValue.String()
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		default:
+			return 0, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+// Greater checks that a > b.
+func Greater(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result <= 0 {
+		Errorf(t, "%v unexpectedly not greater than %v: %v", a, b, msg)
+	}
+}
+
+// GreaterOrEqual checks that a >= b.
+func GreaterOrEqual(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result < 0 {
+		Errorf(t, "%v unexpectedly not greater or equal to %v: %v", a, b, msg)
+	}
+}
+
+// Less checks that a < b.
+func Less(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result >= 0 {
+		Errorf(t, "%v unexpectedly not less than %v: %v", a, b, msg)
+	}
+}
+
+// LessOrEqual checks that a <= b.
+func LessOrEqual(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result > 0 {
+		Errorf(t, "%v unexpectedly not less or equal to %v: %v", a, b, msg)
+	}
+}
+
+// InDeltaOK reports whether a and b are within delta of each other. NaN
+// never compares within delta of anything (including itself) and infinities
+// of the same sign are only within delta of themselves.
+func InDeltaOK(a, b, delta float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return a == b
+	}
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= delta
+}
+
+// InDelta checks that a and b are within delta of each other. NaN never
+// compares within delta of anything (including itself) and infinities of
+// the same sign are only within delta of themselves.
+func InDelta(t *testing.T, a, b, delta float64, msg ...string) {
+	if !InDeltaOK(a, b, delta) {
+		Errorf(t, "%v and %v are not within delta %v: %v", a, b, delta, msg)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Fortio Authors
+
+package assert_test
+
+import (
+	"math"
+	"testing"
+
+	"fortio.org/assert"
+)
+
+func TestCompareOK(t *testing.T) {
+	assert.Greater(t, 3, 2)
+	assert.GreaterOrEqual(t, 2, 2)
+	assert.Less(t, 2, 3)
+	assert.LessOrEqual(t, 2, 2)
+	assert.Greater(t, "b", "a")
+	assert.Greater(t, uint(3), uint(2))
+	assert.GreaterOrEqual(t, uint(2), uint(2))
+	assert.Less(t, uint(2), uint(3))
+	assert.LessOrEqual(t, uint(2), uint(2))
+	assert.Greater(t, 1.5, 1.0)
+	assert.GreaterOrEqual(t, 1.5, 1.5)
+	assert.Less(t, 1.0, 1.5)
+	assert.LessOrEqual(t, 1.5, 1.5)
+	assert.InDelta(t, 1.0, 1.0000001, 0.001)
+}
+
+func TestGreaterFailure(t *testing.T) {
+	tt := &testing.T{}
+	assert.Greater(tt, 2, 3)
+	if !tt.Failed() {
+		t.Error("expecting Greater(2, 3) to fail")
+	}
+}
+
+func TestCompareUnorderedKindDoesNotPanic(t *testing.T) {
+	tt := &testing.T{}
+	assert.Greater(tt, struct{}{}, struct{}{})
+	if !tt.Failed() {
+		t.Error("expecting Greater() on an unordered kind to fail cleanly, not panic")
+	}
+}
+
+func TestInDeltaNaNAndInf(t *testing.T) {
+	tt := &testing.T{}
+	assert.InDelta(tt, math.NaN(), 1, 0.1)
+	if !tt.Failed() {
+		t.Error("expecting InDelta() with NaN to fail")
+	}
+	assert.InDelta(t, math.Inf(1), math.Inf(1), 0.1)
+	tt2 := &testing.T{}
+	assert.InDelta(tt2, math.Inf(1), 1, 0.1)
+	if !tt2.Failed() {
+		t.Error("expecting InDelta() with mismatched Inf to fail")
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2022 Fortio Authors
+
+package assert
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// ErrorIs checks that err matches target, as defined by errors.Is().
+func ErrorIs(t *testing.T, err, target error, msg ...string) {
+	if !errors.Is(err, target) {
+		Errorf(t, "error %v doesn't match target %v: %v", err, target, msg)
+	}
+}
+
+// ErrorAs checks that err can be assigned to target, as defined by
+// errors.As(), and does the assignment on success.
+func ErrorAs(t *testing.T, err error, target interface{}, msg ...string) {
+	if !errors.As(err, target) {
+		Errorf(t, "error %v can't be assigned to %T: %v", err, target, msg)
+	}
+}
+
+// ErrorContains checks that err is not nil and its message contains substr.
+func ErrorContains(t *testing.T, err error, substr string, msg ...string) {
+	if err == nil {
+		Errorf(t, "expecting an error containing %q, didn't get one: %v", substr, msg)
+		return
+	}
+	if !strings.Contains(err.Error(), substr) {
+		Errorf(t, "error %v doesn't contain %q: %v", err, substr, msg)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Fortio Authors
+
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"fortio.org/assert"
+)
+
+var errSentinel = errors.New("sentinel")
+
+func TestErrorIsContains(t *testing.T) {
+	wrapped := fmt.Errorf("wrapping: %w", errSentinel)
+	assert.ErrorIs(t, wrapped, errSentinel)
+	assert.ErrorContains(t, wrapped, "wrapping")
+}
+
+type myError struct{ msg string }
+
+func (e *myError) Error() string { return e.msg }
+
+func TestErrorAs(t *testing.T) {
+	wrapped := fmt.Errorf("outer: %w", &myError{msg: "inner"})
+	var target *myError
+	assert.ErrorAs(t, wrapped, &target)
+	assert.Equal(t, "inner", target.msg)
+}
+
+func TestErrorFailures(t *testing.T) {
+	tt := &testing.T{}
+	assert.ErrorIs(tt, errors.New("other"), errSentinel)
+	if !tt.Failed() {
+		t.Error("expecting ErrorIs() mismatch to fail")
+	}
+
+	tt2 := &testing.T{}
+	assert.ErrorContains(tt2, nil, "whatever")
+	if !tt2.Failed() {
+		t.Error("expecting ErrorContains(nil, ...) to fail")
+	}
+
+	tt3 := &testing.T{}
+	var target *myError
+	assert.ErrorAs(tt3, errSentinel, &target)
+	if !tt3.Failed() {
+		t.Error("expecting ErrorAs() with a non-matching error type to fail")
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Fortio Authors
+
+package assert
+
+import "testing"
+
+// DidPanic runs f and reports whether it panicked and, if so, the recovered
+// value. Exported (beyond what Panics/NotPanics/PanicsWithValue themselves
+// need) so the require subpackage can reuse it.
+func DidPanic(f func()) (panicked bool, value interface{}) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			value = r
+		}
+	}()
+	f()
+	return false, nil
+}
+
+// Panics checks that f() panics.
+func Panics(t *testing.T, f func(), msg ...string) {
+	if panicked, _ := DidPanic(f); !panicked {
+		Errorf(t, "expecting a panic, didn't get one: %v", msg)
+	}
+}
+
+// NotPanics checks that f() doesn't panic.
+func NotPanics(t *testing.T, f func(), msg ...string) {
+	if panicked, value := DidPanic(f); panicked {
+		Errorf(t, "unexpected panic: %v: %v", value, msg)
+	}
+}
+
+// PanicsWithValue checks that f() panics with exactly expected as the
+// recovered value.
+func PanicsWithValue(t *testing.T, expected interface{}, f func(), msg ...string) {
+	panicked, value := DidPanic(f)
+	if !panicked {
+		Errorf(t, "expecting a panic with value %v, didn't get one: %v", expected, msg)
+		return
+	}
+	if !ObjectsAreEqualValues(expected, value) {
+		Errorf(t, "panicked with %v, not expected %v: %v", value, expected, msg)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2022 Fortio Authors
+
+package assert_test
+
+import (
+	"testing"
+
+	"fortio.org/assert"
+)
+
+func TestPanicsOK(t *testing.T) {
+	assert.Panics(t, func() { panic("boom") })
+	assert.NotPanics(t, func() {})
+	assert.PanicsWithValue(t, "boom", func() { panic("boom") })
+}
+
+func TestPanicsFailures(t *testing.T) {
+	tt := &testing.T{}
+	assert.Panics(tt, func() {})
+	if !tt.Failed() {
+		t.Error("expecting Panics() on a non-panicking func to fail")
+	}
+
+	tt2 := &testing.T{}
+	assert.NotPanics(tt2, func() { panic("boom") })
+	if !tt2.Failed() {
+		t.Error("expecting NotPanics() on a panicking func to fail")
+	}
+
+	tt3 := &testing.T{}
+	assert.PanicsWithValue(tt3, "expected", func() { panic("other") })
+	if !tt3.Failed() {
+		t.Error("expecting PanicsWithValue() mismatch to fail")
+	}
+}
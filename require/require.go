@@ -0,0 +1,280 @@
+// Copyright 2022 Fortio Authors
+
+// Package require mirrors fortio.org/assert but stops test execution
+// immediately (using t.FailNow() instead of t.Fail()) on the first failed
+// assertion, the same way testify's require package mirrors its assert
+// package. Use it for preconditions where continuing the test after a
+// failure (e.g. a nil error that's about to be dereferenced) would just
+// crash the test binary instead of reporting a clean failure. The actual
+// comparison logic lives in fortio.org/assert; this package only changes
+// what happens on failure.
+package require // import "fortio.org/assert/require"
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"fortio.org/assert"
+)
+
+// Errorf is a local variant to get the right line numbers and fail fast.
+func Errorf(t *testing.T, format string, rest ...interface{}) {
+	_, file, line, _ := runtime.Caller(2)
+	file = file[strings.LastIndex(file, "/")+1:]
+	fmt.Printf("%s:%d %s", file, line, fmt.Sprintf(format, rest...))
+	t.FailNow()
+}
+
+// NotEqual checks for a not equal b, or aborts the test.
+func NotEqual(t *testing.T, a, b interface{}, msg ...string) {
+	if assert.ObjectsAreEqualValues(a, b) {
+		Errorf(t, "%v unexpectedly equal: %v", a, msg)
+	}
+}
+
+// EqualValues checks for a equal b, or aborts the test.
+func EqualValues(t *testing.T, a, b interface{}, msg ...string) {
+	if !assert.ObjectsAreEqualValues(a, b) {
+		Errorf(t, "%v unexpectedly not equal %v: %v", a, b, msg)
+	}
+}
+
+// Equal also checks for a equal b, or aborts the test.
+func Equal(t *testing.T, a, b interface{}, msg ...string) {
+	if !assert.ObjectsAreEqualValues(a, b) {
+		Errorf(t, "%v unexpectedly not equal %v: %v", a, b, msg)
+	}
+}
+
+// NoError checks for no errors (nil), or aborts the test.
+func NoError(t *testing.T, err error, msg ...string) {
+	if err != nil {
+		Errorf(t, "expecting no error, got %v: %v", err, msg)
+	}
+}
+
+// Error checks/expects an error, or aborts the test.
+func Error(t *testing.T, err error, msg ...string) {
+	if err == nil {
+		Errorf(t, "expecting an error, didn't get it: %v", msg)
+	}
+}
+
+// True checks bool is true, or aborts the test.
+func True(t *testing.T, b bool, msg ...string) {
+	if !b {
+		Errorf(t, "expecting true, didn't: %v", msg)
+	}
+}
+
+// False checks bool is false, or aborts the test.
+func False(t *testing.T, b bool, msg ...string) {
+	if b {
+		Errorf(t, "expecting false, didn't: %v", msg)
+	}
+}
+
+// Contains checks that needle is in haystack: a substring of a string, an
+// element of a slice/array, or a key of a map, or aborts the test.
+func Contains(t *testing.T, haystack, needle interface{}, msg ...string) {
+	ok, found := assert.ContainsElement(haystack, needle)
+	if !ok {
+		Errorf(t, "%v (%T) can't be checked for containment: %v", haystack, haystack, msg)
+		return
+	}
+	if !found {
+		Errorf(t, "%v doesn't contain %v: %v", haystack, needle, msg)
+	}
+}
+
+// Len checks that obj (a slice, array, map, string or channel) has length n,
+// or aborts the test.
+func Len(t *testing.T, obj interface{}, n int, msg ...string) {
+	l, ok := assert.GetLen(obj)
+	if !ok {
+		Errorf(t, "%v (%T) doesn't have a length: %v", obj, obj, msg)
+		return
+	}
+	if l != n {
+		Errorf(t, "%v has length %d, not %d: %v", obj, l, n, msg)
+	}
+}
+
+// Empty checks that obj is empty as defined by fortio.org/assert.IsEmpty, or
+// aborts the test.
+func Empty(t *testing.T, obj interface{}, msg ...string) {
+	if !assert.IsEmpty(obj) {
+		Errorf(t, "%v unexpectedly not empty: %v", obj, msg)
+	}
+}
+
+// NotEmpty checks that obj is not empty as defined by
+// fortio.org/assert.IsEmpty, or aborts the test.
+func NotEmpty(t *testing.T, obj interface{}, msg ...string) {
+	if assert.IsEmpty(obj) {
+		Errorf(t, "unexpectedly empty: %v", msg)
+	}
+}
+
+// ElementsMatch checks that listA and listB (slices or arrays) contain the
+// same elements, irrespective of order, or aborts the test.
+func ElementsMatch(t *testing.T, listA, listB interface{}, msg ...string) {
+	if assert.IsEmpty(listA) && assert.IsEmpty(listB) {
+		return
+	}
+	aValue, aOK := assert.SequenceValue(listA)
+	bValue, bOK := assert.SequenceValue(listB)
+	if !aOK || !bOK {
+		Errorf(t, "%v (%T) and %v (%T) must both be a slice or array: %v", listA, listA, listB, listB, msg)
+		return
+	}
+	if aValue.Len() != bValue.Len() {
+		Errorf(t, "%v and %v don't have the same length: %v", listA, listB, msg)
+		return
+	}
+	if extra := assert.DiffElements(aValue, bValue); len(extra) > 0 {
+		Errorf(t, "%v and %v don't contain the same elements, unmatched: %v: %v", listA, listB, extra, msg)
+	}
+}
+
+// Subset checks that every element of sub (a slice or array) is present in
+// super, or aborts the test.
+func Subset(t *testing.T, super, sub interface{}, msg ...string) {
+	if assert.IsEmpty(sub) {
+		return
+	}
+	superValue, superOK := assert.SequenceValue(super)
+	subValue, subOK := assert.SequenceValue(sub)
+	if !superOK || !subOK {
+		Errorf(t, "%v (%T) and %v (%T) must both be a slice or array: %v", super, super, sub, sub, msg)
+		return
+	}
+	for i := 0; i < subValue.Len(); i++ {
+		elem := subValue.Index(i).Interface()
+		found := false
+		for j := 0; j < superValue.Len(); j++ {
+			if assert.ObjectsAreEqualValues(elem, superValue.Index(j).Interface()) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			Errorf(t, "%v is not a subset of %v, missing %v: %v", sub, super, elem, msg)
+			return
+		}
+	}
+}
+
+// Greater checks that a > b, or aborts the test.
+func Greater(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := assert.Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result <= 0 {
+		Errorf(t, "%v unexpectedly not greater than %v: %v", a, b, msg)
+	}
+}
+
+// GreaterOrEqual checks that a >= b, or aborts the test.
+func GreaterOrEqual(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := assert.Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result < 0 {
+		Errorf(t, "%v unexpectedly not greater or equal to %v: %v", a, b, msg)
+	}
+}
+
+// Less checks that a < b, or aborts the test.
+func Less(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := assert.Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result >= 0 {
+		Errorf(t, "%v unexpectedly not less than %v: %v", a, b, msg)
+	}
+}
+
+// LessOrEqual checks that a <= b, or aborts the test.
+func LessOrEqual(t *testing.T, a, b interface{}, msg ...string) {
+	result, ordered := assert.Compare(a, b)
+	if !ordered {
+		Errorf(t, "%T and %T can't be compared: %v", a, b, msg)
+		return
+	}
+	if result > 0 {
+		Errorf(t, "%v unexpectedly not less or equal to %v: %v", a, b, msg)
+	}
+}
+
+// InDelta checks that a and b are within delta of each other, or aborts the
+// test. See fortio.org/assert.InDeltaOK for the NaN/Inf handling rules.
+func InDelta(t *testing.T, a, b, delta float64, msg ...string) {
+	if !assert.InDeltaOK(a, b, delta) {
+		Errorf(t, "%v and %v are not within delta %v: %v", a, b, delta, msg)
+	}
+}
+
+// ErrorIs checks that err matches target, as defined by errors.Is(), or
+// aborts the test.
+func ErrorIs(t *testing.T, err, target error, msg ...string) {
+	if !errors.Is(err, target) {
+		Errorf(t, "error %v doesn't match target %v: %v", err, target, msg)
+	}
+}
+
+// ErrorAs checks that err can be assigned to target, as defined by
+// errors.As(), or aborts the test.
+func ErrorAs(t *testing.T, err error, target interface{}, msg ...string) {
+	if !errors.As(err, target) {
+		Errorf(t, "error %v can't be assigned to %T: %v", err, target, msg)
+	}
+}
+
+// ErrorContains checks that err is not nil and its message contains substr,
+// or aborts the test.
+func ErrorContains(t *testing.T, err error, substr string, msg ...string) {
+	if err == nil {
+		Errorf(t, "expecting an error containing %q, didn't get one: %v", substr, msg)
+		return
+	}
+	if !strings.Contains(err.Error(), substr) {
+		Errorf(t, "error %v doesn't contain %q: %v", err, substr, msg)
+	}
+}
+
+// Panics checks that f() panics, or aborts the test.
+func Panics(t *testing.T, f func(), msg ...string) {
+	if panicked, _ := assert.DidPanic(f); !panicked {
+		Errorf(t, "expecting a panic, didn't get one: %v", msg)
+	}
+}
+
+// NotPanics checks that f() doesn't panic, or aborts the test.
+func NotPanics(t *testing.T, f func(), msg ...string) {
+	if panicked, value := assert.DidPanic(f); panicked {
+		Errorf(t, "unexpected panic: %v: %v", value, msg)
+	}
+}
+
+// PanicsWithValue checks that f() panics with exactly expected as the
+// recovered value, or aborts the test.
+func PanicsWithValue(t *testing.T, expected interface{}, f func(), msg ...string) {
+	panicked, value := assert.DidPanic(f)
+	if !panicked {
+		Errorf(t, "expecting a panic with value %v, didn't get one: %v", expected, msg)
+		return
+	}
+	if !assert.ObjectsAreEqualValues(expected, value) {
+		Errorf(t, "panicked with %v, not expected %v: %v", value, expected, msg)
+	}
+}
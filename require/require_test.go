@@ -0,0 +1,107 @@
+// Copyright 2022 Fortio Authors
+
+package require_test
+
+import (
+	"errors"
+	"testing"
+
+	"fortio.org/assert/require"
+)
+
+func TestRequireOK(t *testing.T) {
+	require.Equal(t, 1, 1)
+	require.EqualValues(t, 1, 1)
+	require.NotEqual(t, 1, 2)
+	require.NoError(t, nil)
+	require.Error(t, errors.New("boom"))
+	require.True(t, true)
+	require.False(t, false)
+	require.Contains(t, "hello world", "world")
+	require.Len(t, []int{1, 2, 3}, 3)
+	require.Empty(t, "")
+	require.NotEmpty(t, []int{1})
+	require.ElementsMatch(t, []int{1, 2}, []int{2, 1})
+	require.Subset(t, []int{1, 2, 3}, []int{3, 1})
+	require.Greater(t, 3, 2)
+	require.GreaterOrEqual(t, 2, 2)
+	require.Less(t, 2, 3)
+	require.LessOrEqual(t, 2, 2)
+	require.InDelta(t, 1.0, 1.0000001, 0.001)
+	require.ErrorIs(t, errSentinel, errSentinel)
+	var target *myError
+	require.ErrorAs(t, errSentinel2, &target)
+	require.ErrorContains(t, errSentinel, "sentinel")
+	require.Panics(t, func() { panic("boom") })
+	require.NotPanics(t, func() {})
+	require.PanicsWithValue(t, "boom", func() { panic("boom") })
+}
+
+var errSentinel = errors.New("sentinel")
+
+type myError struct{}
+
+func (*myError) Error() string { return "my-error" }
+
+var errSentinel2 error = &myError{}
+
+// runFailFast runs f in its own goroutine since require's fail-fast helpers
+// call t.FailNow(), which (like testing.T.FailNow()) must run on the test's
+// own goroutine and stops it via runtime.Goexit().
+func runFailFast(t *testing.T, f func(t *testing.T)) bool {
+	tt := &testing.T{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(tt)
+	}()
+	<-done
+	return tt.Failed()
+}
+
+// failFastCases covers every require assertion's failure branch so a
+// copy/paste mistake against the assert package (flipped sign, wrong field,
+// etc.) shows up as a test failure rather than silently passing.
+var failFastCases = []struct {
+	name string
+	f    func(tt *testing.T)
+}{
+	{"Equal", func(tt *testing.T) { require.Equal(tt, 1, 2) }},
+	{"EqualValues", func(tt *testing.T) { require.EqualValues(tt, 1, 2) }},
+	{"NotEqual", func(tt *testing.T) { require.NotEqual(tt, 1, 1) }},
+	{"NoError", func(tt *testing.T) { require.NoError(tt, errors.New("boom")) }},
+	{"Error", func(tt *testing.T) { require.Error(tt, nil) }},
+	{"True", func(tt *testing.T) { require.True(tt, false) }},
+	{"False", func(tt *testing.T) { require.False(tt, true) }},
+	{"Contains", func(tt *testing.T) { require.Contains(tt, "hello", "world") }},
+	{"Len", func(tt *testing.T) { require.Len(tt, []int{1}, 2) }},
+	{"Empty", func(tt *testing.T) { require.Empty(tt, []int{1}) }},
+	{"NotEmpty", func(tt *testing.T) { require.NotEmpty(tt, []int{}) }},
+	{"ElementsMatch", func(tt *testing.T) { require.ElementsMatch(tt, 5, []int{1}) }},
+	{"Subset", func(tt *testing.T) { require.Subset(tt, []int{1, 2}, []int{3}) }},
+	{"Greater", func(tt *testing.T) { require.Greater(tt, 2, 3) }},
+	{"GreaterOrEqual", func(tt *testing.T) { require.GreaterOrEqual(tt, 2, 3) }},
+	{"Less", func(tt *testing.T) { require.Less(tt, 3, 2) }},
+	{"LessOrEqual", func(tt *testing.T) { require.LessOrEqual(tt, 3, 2) }},
+	{"InDelta", func(tt *testing.T) { require.InDelta(tt, 1.0, 2.0, 0.1) }},
+	{"ErrorIs", func(tt *testing.T) { require.ErrorIs(tt, errors.New("other"), errSentinel) }},
+	{"ErrorAs", func(tt *testing.T) {
+		var target *myError
+		require.ErrorAs(tt, errSentinel, &target)
+	}},
+	{"ErrorContains", func(tt *testing.T) { require.ErrorContains(tt, errSentinel, "nope") }},
+	{"Panics", func(tt *testing.T) { require.Panics(tt, func() {}) }},
+	{"NotPanics", func(tt *testing.T) { require.NotPanics(tt, func() { panic("boom") }) }},
+	{"PanicsWithValue", func(tt *testing.T) { require.PanicsWithValue(tt, "expected", func() { panic("other") }) }},
+}
+
+func TestRequireFailFast(t *testing.T) {
+	for _, c := range failFastCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			if !runFailFast(t, c.f) {
+				t.Errorf("expecting require.%s to fail", c.name)
+			}
+		})
+	}
+}
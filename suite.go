@@ -0,0 +1,104 @@
+// Copyright 2022 Fortio Authors
+
+package assert
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+type hasT interface {
+	T() *testing.T
+	SetT(*testing.T)
+}
+
+// TestSuite to be used as base struct for test suites.
+// replaces https://pkg.go.dev/github.com/stretchr/testify@v1.8.0/suite
+type TestSuite struct {
+	t *testing.T
+}
+
+// T returns the current testing.T.
+func (s *TestSuite) T() *testing.T {
+	return s.t
+}
+
+// SetT sets the testing.T in the suite object.
+func (s *TestSuite) SetT(t *testing.T) {
+	s.t = t
+}
+
+type hasSetupTest interface {
+	SetupTest()
+}
+type hasTearDown interface {
+	TearDownTest()
+}
+type hasSetupSuite interface {
+	SetupSuite()
+}
+type hasTearDownSuite interface {
+	TearDownSuite()
+}
+type hasBeforeTest interface {
+	BeforeTest(suiteName, testName string)
+}
+type hasAfterTest interface {
+	AfterTest(suiteName, testName string)
+}
+
+// Run runs the test suite, calling SetupSuite/TearDownSuite once around the
+// whole suite and SetupTest/TearDownTest plus BeforeTest/AfterTest around
+// every test method, the same as testify's suite package. Unlike testify,
+// each test method is handed its own copy of suite (starting from the state
+// SetupSuite left it in), so a test calling t.Parallel() gets its own T
+// instead of racing with other parallel tests over a single shared field.
+// replaces https://pkg.go.dev/github.com/stretchr/testify/suite#Run
+func Run(t *testing.T, suite hasT) {
+	suiteValue := reflect.ValueOf(suite)
+	if suiteValue.Kind() != reflect.Ptr {
+		Fail(t, "Run: suite must be a pointer to a struct embedding TestSuite")
+		return
+	}
+	elemType := suiteValue.Elem().Type()
+	suiteName := elemType.Name()
+	suite.SetT(t)
+	if s, ok := suite.(hasSetupSuite); ok {
+		s.SetupSuite()
+	}
+	if s, ok := suite.(hasTearDownSuite); ok {
+		// t.Cleanup (not defer) so teardown runs after every subtest,
+		// including t.Parallel() ones, actually finishes: Run() returns (and
+		// a defer here would fire) before a parallel subtest's body runs.
+		t.Cleanup(s.TearDownSuite)
+	}
+	methodFinder := reflect.TypeOf(suite)
+	for i := 0; i < methodFinder.NumMethod(); i++ {
+		method := methodFinder.Method(i)
+		//nolint:staticcheck // consider fixing later for perf but this is just to run a few tests.
+		if ok, _ := regexp.MatchString("^Test", method.Name); !ok {
+			continue
+		}
+		testName := method.Name
+		t.Run(testName, func(t *testing.T) {
+			testSuite := reflect.New(elemType)
+			testSuite.Elem().Set(suiteValue.Elem())
+			testSuiteIface := testSuite.Interface().(hasT)
+			testSuiteIface.SetT(t)
+			if before, ok := testSuiteIface.(hasBeforeTest); ok {
+				before.BeforeTest(suiteName, testName)
+			}
+			if setup, ok := testSuiteIface.(hasSetupTest); ok {
+				setup.SetupTest()
+			}
+			testSuite.MethodByName(testName).Call(nil)
+			if tearDown, ok := testSuiteIface.(hasTearDown); ok {
+				tearDown.TearDownTest()
+			}
+			if after, ok := testSuiteIface.(hasAfterTest); ok {
+				after.AfterTest(suiteName, testName)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Fortio Authors
+
+package assert_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"fortio.org/assert"
+)
+
+// events is a shared recorder pointed to from the suite struct: Run() clones
+// the suite per test method, so any state a test needs to see reflected back
+// (like this log) must live behind a pointer the clones all share, not in a
+// plain field that gets copied.
+type events struct {
+	mu  sync.Mutex
+	log []string
+}
+
+func (e *events) record(s string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.log = append(e.log, s)
+}
+
+type parallelSuite struct {
+	assert.TestSuite
+	ev *events
+}
+
+func (s *parallelSuite) TearDownSuite() {
+	s.ev.record("teardown")
+}
+
+func (s *parallelSuite) TestParallel() {
+	t := s.T()
+	t.Parallel()
+	time.Sleep(10 * time.Millisecond)
+	s.ev.record("body")
+}
+
+// TestSuiteTearDownAfterParallel guards against TearDownSuite firing before
+// a t.Parallel() subtest's body has actually run (see suite.go's Run()).
+func TestSuiteTearDownAfterParallel(t *testing.T) {
+	ev := &events{}
+	suite := &parallelSuite{ev: ev}
+	t.Cleanup(func() {
+		ev.mu.Lock()
+		defer ev.mu.Unlock()
+		if len(ev.log) != 2 || ev.log[0] != "body" || ev.log[1] != "teardown" {
+			t.Errorf("expected [body teardown], got %v", ev.log)
+		}
+	})
+	assert.Run(t, suite)
+}
+
+type lifecycleSuite struct {
+	assert.TestSuite
+	ev *events
+}
+
+func (s *lifecycleSuite) SetupSuite()    { s.ev.record("setup-suite") }
+func (s *lifecycleSuite) TearDownSuite() { s.ev.record("teardown-suite") }
+func (s *lifecycleSuite) SetupTest()     { s.ev.record("setup-test") }
+func (s *lifecycleSuite) TearDownTest()  { s.ev.record("teardown-test") }
+
+func (s *lifecycleSuite) BeforeTest(suiteName, testName string) {
+	s.ev.record("before:" + suiteName + "." + testName)
+}
+
+func (s *lifecycleSuite) AfterTest(suiteName, testName string) {
+	s.ev.record("after:" + suiteName + "." + testName)
+}
+
+func (s *lifecycleSuite) TestOne() {
+	s.ev.record("test-one")
+}
+
+func TestSuiteLifecycleHooks(t *testing.T) {
+	ev := &events{}
+	suite := &lifecycleSuite{ev: ev}
+	// Registered before Run() so it executes after Run()'s own TearDownSuite
+	// t.Cleanup (cleanups run LIFO) and therefore sees the full log.
+	t.Cleanup(func() {
+		want := []string{
+			"setup-suite",
+			"before:lifecycleSuite.TestOne",
+			"setup-test",
+			"test-one",
+			"teardown-test",
+			"after:lifecycleSuite.TestOne",
+			"teardown-suite",
+		}
+		assert.Equal(t, want, ev.log)
+	})
+	assert.Run(t, suite)
+}